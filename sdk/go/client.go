@@ -0,0 +1,110 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feast
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+)
+
+// Client is the interface for a Feast Serving client, implemented by both
+// GrpcClient and HttpClient.
+type Client interface {
+	GetOnlineFeatures(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error)
+	GetFeastServingInfo(ctx context.Context, in *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error)
+	Close() error
+}
+
+// GrpcClient is a gRPC client for Feast Serving.
+type GrpcClient struct {
+	cli          serving.ServingServiceClient
+	conn         *grpc.ClientConn
+	interceptors []Interceptor
+}
+
+// GrpcClientOption configures a GrpcClient.
+type GrpcClientOption func(*GrpcClient)
+
+// WithInterceptors registers interceptors to run, in order, around every
+// GetOnlineFeatures call made by the client.
+func WithInterceptors(interceptors ...Interceptor) GrpcClientOption {
+	return func(fc *GrpcClient) {
+		fc.interceptors = append(fc.interceptors, interceptors...)
+	}
+}
+
+// NewGrpcClient constructs a client that can query Feast Serving over gRPC.
+func NewGrpcClient(host string, port int, opts ...GrpcClientOption) (*GrpcClient, error) {
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", host, port), grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	fc := &GrpcClient{
+		cli:  serving.NewServingServiceClient(conn),
+		conn: conn,
+	}
+	for _, opt := range opts {
+		opt(fc)
+	}
+	return fc, nil
+}
+
+// GetOnlineFeatures retrieves the latest online feature values from Feast Serving,
+// passing the call through any configured interceptors first.
+func (fc *GrpcClient) GetOnlineFeatures(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+	return chainInterceptors(fc.interceptors, fc.getOnlineFeatures)(ctx, req)
+}
+
+func (fc *GrpcClient) getOnlineFeatures(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+	featuresRequest, err := req.buildRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fc.cli.GetOnlineFeaturesV2(ctx, featuresRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OnlineFeaturesResponse{RawResponse: resp}, nil
+}
+
+// NewGrpcClientForConn constructs a GrpcClient around an already-established
+// gRPC connection, e.g. one dialed against an in-process bufconn listener in
+// tests. Most callers should use NewGrpcClient instead.
+func NewGrpcClientForConn(conn *grpc.ClientConn, opts ...GrpcClientOption) *GrpcClient {
+	fc := &GrpcClient{
+		cli:  serving.NewServingServiceClient(conn),
+		conn: conn,
+	}
+	for _, opt := range opts {
+		opt(fc)
+	}
+	return fc
+}
+
+// GetFeastServingInfo returns metadata about the Feast Serving deployment being queried.
+func (fc *GrpcClient) GetFeastServingInfo(ctx context.Context, in *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error) {
+	return fc.cli.GetFeastServingInfo(ctx, in)
+}
+
+// Close closes the underlying gRPC connection.
+func (fc *GrpcClient) Close() error {
+	return fc.conn.Close()
+}
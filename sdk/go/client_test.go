@@ -82,3 +82,40 @@ func TestGetOnlineFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOnlineFeaturesWithInterceptors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cli := mock_serving.NewMockServingServiceClient(ctrl)
+	ctx := context.Background()
+
+	req := OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []Row{{"driver_id": Int64Val(1)}},
+		Project:  "driver_project",
+	}
+	rawRequest, _ := req.buildRequest()
+	rawResponse := &serving.GetOnlineFeaturesResponse{}
+	cli.EXPECT().GetOnlineFeaturesV2(ctx, rawRequest).Return(rawResponse, nil).Times(1)
+
+	var calls []string
+	recording := func(name string) Interceptor {
+		return func(ctx context.Context, req *OnlineFeaturesRequest, next Handler) (*OnlineFeaturesResponse, error) {
+			calls = append(calls, name)
+			return next(ctx, req)
+		}
+	}
+
+	client := &GrpcClient{
+		cli:          cli,
+		interceptors: []Interceptor{recording("outer"), recording("inner")},
+	}
+
+	if _, err := client.GetOnlineFeatures(ctx, &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(calls) != 2 || calls[0] != "outer" || calls[1] != "inner" {
+		t.Errorf("got interceptor calls %v, want [outer inner]", calls)
+	}
+}
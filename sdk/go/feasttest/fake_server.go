@@ -0,0 +1,276 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package feasttest provides an in-process fake Feast Serving gRPC server for
+// use in SDK and application tests, so that callers can seed feature values
+// and assert on client behaviour without reasoning about gomock expectations
+// or Feast Serving's wire types directly.
+package feasttest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+)
+
+const bufSize = 1024 * 1024
+
+// FakeServer is an in-process fake implementation of Feast Serving, backed by
+// a bufconn listener. It lets tests seed feature values with a fluent API,
+// script error responses, and assert on the requests it received.
+type FakeServer struct {
+	serving.UnimplementedServingServiceServer
+
+	listener *bufconn.Listener
+	server   *grpc.Server
+
+	mu sync.Mutex
+	// entityKey (project+entity) -> featureRef -> value. A stored nil value
+	// denotes an explicitly null feature, as opposed to one that was never set
+	// (not found).
+	values map[string]map[string]*types.Value
+	errors []*scriptedError
+
+	requests []*serving.GetOnlineFeaturesRequestV2
+}
+
+// NewFakeServer starts a FakeServer listening on an in-memory bufconn.
+func NewFakeServer() *FakeServer {
+	fs := &FakeServer{
+		listener: bufconn.Listen(bufSize),
+		values:   map[string]map[string]*types.Value{},
+	}
+	fs.server = grpc.NewServer()
+	serving.RegisterServingServiceServer(fs.server, fs)
+	go fs.server.Serve(fs.listener)
+	return fs
+}
+
+// Close stops the fake gRPC server and releases its listener.
+func (fs *FakeServer) Close() {
+	fs.server.Stop()
+}
+
+// Dial returns a *feast.GrpcClient connected to this FakeServer. The
+// underlying connection is closed automatically when the test completes.
+func (fs *FakeServer) Dial(t *testing.T) *feast.GrpcClient {
+	t.Helper()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return fs.listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("feasttest: failed to dial fake server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return feast.NewGrpcClientForConn(conn)
+}
+
+// EntityBuilder configures the feature values the FakeServer returns for a
+// single entity key, returned by OnEntity.
+type EntityBuilder struct {
+	fs  *FakeServer
+	key string
+}
+
+// OnEntity seeds (or returns the existing builder for) the entity identified
+// by name=val in the default (empty) project, e.g.
+// OnEntity("driver_id", feast.Int64Val(1)). Use OnProject to scope seeded
+// values to a specific project.
+func (fs *FakeServer) OnEntity(name string, val *types.Value) *EntityBuilder {
+	return fs.OnProject("").OnEntity(name, val)
+}
+
+// ProjectScope scopes seeded entities to a single Feast project, so that the
+// same entity id can be seeded with different feature values per project and
+// GetOnlineFeaturesV2 routes lookups by req.Project.
+type ProjectScope struct {
+	fs      *FakeServer
+	project string
+}
+
+// OnProject scopes subsequent OnEntity calls to project. Requests are routed
+// to the project they name; the empty string is the default project.
+func (fs *FakeServer) OnProject(project string) *ProjectScope {
+	return &ProjectScope{fs: fs, project: project}
+}
+
+// OnEntity seeds (or returns the existing builder for) the entity identified
+// by name=val within this project.
+func (p *ProjectScope) OnEntity(name string, val *types.Value) *EntityBuilder {
+	key := entityKey(p.project, name, val)
+
+	p.fs.mu.Lock()
+	if _, ok := p.fs.values[key]; !ok {
+		p.fs.values[key] = map[string]*types.Value{}
+	}
+	p.fs.mu.Unlock()
+
+	return &EntityBuilder{fs: p.fs, key: key}
+}
+
+// SetFeature seeds the value Feast Serving should return for ref (e.g.
+// "driver:rating") when queried for this entity.
+func (b *EntityBuilder) SetFeature(ref string, val *types.Value) *EntityBuilder {
+	b.fs.mu.Lock()
+	b.fs.values[b.key][ref] = val
+	b.fs.mu.Unlock()
+	return b
+}
+
+// SetNull seeds ref so that it is returned with FieldStatus NULL_VALUE, as
+// opposed to simply being absent (NOT_FOUND).
+func (b *EntityBuilder) SetNull(ref string) *EntityBuilder {
+	return b.SetFeature(ref, nil)
+}
+
+// scriptedError is a queued error response, consumed by GetOnlineFeaturesV2
+// until its remaining count reaches zero. A negative remaining count means
+// the error persists indefinitely.
+type scriptedError struct {
+	err       error
+	remaining int
+}
+
+// ScriptedError controls how many times a scripted error response is
+// returned before the FakeServer falls through to its seeded values.
+type ScriptedError struct {
+	se *scriptedError
+}
+
+// Times limits the scripted error to the next n requests.
+func (s *ScriptedError) Times(n int) *ScriptedError {
+	s.se.remaining = n
+	return s
+}
+
+// Persist makes the scripted error apply to every subsequent request.
+func (s *ScriptedError) Persist() *ScriptedError {
+	s.se.remaining = -1
+	return s
+}
+
+// ReturnError queues err to be returned by the next GetOnlineFeaturesV2 call,
+// by default exactly once. Chain Times or Persist to change that.
+func (fs *FakeServer) ReturnError(err error) *ScriptedError {
+	se := &scriptedError{err: err, remaining: 1}
+
+	fs.mu.Lock()
+	fs.errors = append(fs.errors, se)
+	fs.mu.Unlock()
+
+	return &ScriptedError{se: se}
+}
+
+// Requests returns every GetOnlineFeaturesV2 request the FakeServer has
+// received so far, for use in assertions on the project, feature refs and
+// entities a client actually sent. GetFeastServingInfo calls are not recorded.
+func (fs *FakeServer) Requests() []*serving.GetOnlineFeaturesRequestV2 {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return append([]*serving.GetOnlineFeaturesRequestV2(nil), fs.requests...)
+}
+
+// GetOnlineFeaturesV2 implements serving.ServingServiceServer.
+func (fs *FakeServer) GetOnlineFeaturesV2(ctx context.Context, req *serving.GetOnlineFeaturesRequestV2) (*serving.GetOnlineFeaturesResponse, error) {
+	fs.mu.Lock()
+	fs.requests = append(fs.requests, req)
+
+	if err, ok := fs.nextScriptedErrorLocked(); ok {
+		fs.mu.Unlock()
+		return nil, err
+	}
+	fs.mu.Unlock()
+
+	fieldValues := make([]*serving.GetOnlineFeaturesResponse_FieldValues, len(req.EntityRows))
+	for i, row := range req.EntityRows {
+		fields := map[string]*types.Value{}
+		statuses := map[string]serving.GetOnlineFeaturesResponse_FieldStatus{}
+
+		for _, ref := range req.Features {
+			val, status := fs.lookup(req.Project, row.Fields, ref.Name)
+			fields[ref.Name] = val
+			statuses[ref.Name] = status
+		}
+
+		fieldValues[i] = &serving.GetOnlineFeaturesResponse_FieldValues{
+			Fields:   fields,
+			Statuses: statuses,
+		}
+	}
+
+	return &serving.GetOnlineFeaturesResponse{FieldValues: fieldValues}, nil
+}
+
+// GetFeastServingInfo implements serving.ServingServiceServer.
+func (fs *FakeServer) GetFeastServingInfo(ctx context.Context, req *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error) {
+	return &serving.GetFeastServingInfoResponse{}, nil
+}
+
+func (fs *FakeServer) nextScriptedErrorLocked() (error, bool) {
+	for len(fs.errors) > 0 && fs.errors[0].remaining == 0 {
+		fs.errors = fs.errors[1:]
+	}
+	if len(fs.errors) == 0 {
+		return nil, false
+	}
+
+	se := fs.errors[0]
+	if se.remaining > 0 {
+		se.remaining--
+	}
+	if se.remaining == 0 {
+		fs.errors = fs.errors[1:]
+	}
+	return se.err, true
+}
+
+func (fs *FakeServer) lookup(project string, entityFields map[string]*types.Value, featureRef string) (*types.Value, serving.GetOnlineFeaturesResponse_FieldStatus) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	for name, val := range entityFields {
+		features, ok := fs.values[entityKey(project, name, val)]
+		if !ok {
+			continue
+		}
+		fv, ok := features[featureRef]
+		if !ok {
+			continue
+		}
+		if fv == nil {
+			return &types.Value{}, serving.GetOnlineFeaturesResponse_NULL_VALUE
+		}
+		return fv, serving.GetOnlineFeaturesResponse_PRESENT
+	}
+
+	return &types.Value{}, serving.GetOnlineFeaturesResponse_NOT_FOUND
+}
+
+func entityKey(project, name string, val *types.Value) string {
+	return fmt.Sprintf("%s|%s=%s", project, name, val.String())
+}
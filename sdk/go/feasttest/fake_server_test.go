@@ -0,0 +1,136 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feasttest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/status"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+)
+
+func TestFakeServerServesSeededValues(t *testing.T) {
+	fake := NewFakeServer()
+	defer fake.Close()
+
+	fake.OnProject("driver_project").OnEntity("driver_id", feast.Int64Val(1)).
+		SetFeature("driver:rating", feast.Int64Val(5)).
+		SetNull("driver:null_value")
+
+	client := fake.Dial(t)
+	got, err := client.GetOnlineFeatures(context.Background(), &feast.OnlineFeaturesRequest{
+		Features: []string{"driver:rating", "driver:null_value", "driver:missing"},
+		Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+		Project:  "driver_project",
+	})
+	if err != nil {
+		t.Fatalf("GetOnlineFeatures returned error: %v", err)
+	}
+
+	fields := got.RawResponse.FieldValues[0]
+	if fields.Statuses["driver:rating"] != serving.GetOnlineFeaturesResponse_PRESENT {
+		t.Errorf("driver:rating status = %v, want PRESENT", fields.Statuses["driver:rating"])
+	}
+	if fields.Statuses["driver:null_value"] != serving.GetOnlineFeaturesResponse_NULL_VALUE {
+		t.Errorf("driver:null_value status = %v, want NULL_VALUE", fields.Statuses["driver:null_value"])
+	}
+	if fields.Statuses["driver:missing"] != serving.GetOnlineFeaturesResponse_NOT_FOUND {
+		t.Errorf("driver:missing status = %v, want NOT_FOUND", fields.Statuses["driver:missing"])
+	}
+
+	requests := fake.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	if requests[0].Project != "driver_project" {
+		t.Errorf("request project = %q, want driver_project", requests[0].Project)
+	}
+}
+
+func TestFakeServerRoutesByProject(t *testing.T) {
+	fake := NewFakeServer()
+	defer fake.Close()
+
+	fake.OnProject("project_a").OnEntity("driver_id", feast.Int64Val(1)).SetFeature("driver:rating", feast.Int64Val(1))
+	fake.OnProject("project_b").OnEntity("driver_id", feast.Int64Val(1)).SetFeature("driver:rating", feast.Int64Val(2))
+
+	client := fake.Dial(t)
+	req := func(project string) *feast.OnlineFeaturesRequest {
+		return &feast.OnlineFeaturesRequest{
+			Features: []string{"driver:rating"},
+			Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+			Project:  project,
+		}
+	}
+
+	gotA, err := client.GetOnlineFeatures(context.Background(), req("project_a"))
+	if err != nil {
+		t.Fatalf("project_a: unexpected error: %v", err)
+	}
+	gotB, err := client.GetOnlineFeatures(context.Background(), req("project_b"))
+	if err != nil {
+		t.Fatalf("project_b: unexpected error: %v", err)
+	}
+
+	if ratingA := gotA.RawResponse.FieldValues[0].Fields["driver:rating"].GetInt64Val(); ratingA != 1 {
+		t.Errorf("project_a driver:rating = %d, want 1", ratingA)
+	}
+	if ratingB := gotB.RawResponse.FieldValues[0].Fields["driver:rating"].GetInt64Val(); ratingB != 2 {
+		t.Errorf("project_b driver:rating = %d, want 2", ratingB)
+	}
+
+	gotC, err := client.GetOnlineFeatures(context.Background(), req("project_c"))
+	if err != nil {
+		t.Fatalf("project_c: unexpected error: %v", err)
+	}
+	if status := gotC.RawResponse.FieldValues[0].Statuses["driver:rating"]; status != serving.GetOnlineFeaturesResponse_NOT_FOUND {
+		t.Errorf("project_c driver:rating status = %v, want NOT_FOUND (no cross-project leakage)", status)
+	}
+}
+
+func TestFakeServerScriptedError(t *testing.T) {
+	fake := NewFakeServer()
+	defer fake.Close()
+
+	wantErr := errors.New("serving unavailable")
+	fake.ReturnError(wantErr).Times(2)
+	fake.OnProject("driver_project").OnEntity("driver_id", feast.Int64Val(1)).SetFeature("driver:rating", feast.Int64Val(5))
+
+	client := fake.Dial(t)
+	req := &feast.OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+		Project:  "driver_project",
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetOnlineFeatures(context.Background(), req)
+		if err == nil || status.Convert(err).Message() != wantErr.Error() {
+			t.Fatalf("call %d: got error %v, want %v", i, err, wantErr)
+		}
+	}
+
+	got, err := client.GetOnlineFeatures(context.Background(), req)
+	if err != nil {
+		t.Fatalf("call 3: unexpected error: %v", err)
+	}
+	if got.RawResponse.FieldValues[0].Statuses["driver:rating"] != serving.GetOnlineFeaturesResponse_PRESENT {
+		t.Errorf("expected scripted error to stop applying after 2 calls")
+	}
+}
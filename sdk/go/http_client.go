@@ -0,0 +1,190 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feast
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+)
+
+// HTTPClientOption configures an HttpClient.
+type HTTPClientOption func(*HttpClient)
+
+// WithHTTPClient overrides the underlying http.Client used to issue requests,
+// e.g. to configure timeouts or a custom transport.
+func WithHTTPClient(httpClient *http.Client) HTTPClientOption {
+	return func(c *HttpClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithBearerToken attaches a static bearer/OIDC token to every outgoing request
+// via the Authorization header.
+func WithBearerToken(token string) HTTPClientOption {
+	return func(c *HttpClient) {
+		c.authHeader = "Bearer " + token
+	}
+}
+
+// WithAuthHeader attaches a pre-formatted Authorization header value, e.g.
+// "Bearer <token>" or "Basic <creds>", to every outgoing request.
+func WithAuthHeader(header string) HTTPClientOption {
+	return func(c *HttpClient) {
+		c.authHeader = header
+	}
+}
+
+// HttpClient talks to Feast Serving's HTTP/JSON gateway. It implements the
+// same Client interface as GrpcClient, for use in environments where gRPC is
+// not available (e.g. behind HTTP-only proxies, or from server-side
+// aggregators fronting browser clients).
+type HttpClient struct {
+	baseURL    string
+	httpClient *http.Client
+	authHeader string
+}
+
+// NewHTTPClient constructs a client that queries Feast Serving's HTTP/JSON
+// gateway at baseURL, e.g. "https://serving.feast.example.com".
+func NewHTTPClient(baseURL string, opts ...HTTPClientOption) *HttpClient {
+	c := &HttpClient{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetOnlineFeatures retrieves the latest online feature values from Feast
+// Serving's HTTP/JSON gateway.
+func (hc *HttpClient) GetOnlineFeatures(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+	rawRequest, err := req.buildRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := protojson.Marshal(rawRequest)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal OnlineFeaturesRequest: %v", err)
+	}
+
+	respBody, err := hc.post(ctx, "/api/v1/serving/online-features", body)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse := &serving.GetOnlineFeaturesResponse{}
+	if err := protojson.Unmarshal(respBody, rawResponse); err != nil {
+		return nil, fmt.Errorf("could not unmarshal GetOnlineFeaturesResponse: %v", err)
+	}
+
+	return &OnlineFeaturesResponse{RawResponse: rawResponse}, nil
+}
+
+// GetFeastServingInfo returns metadata about the Feast Serving deployment being queried.
+func (hc *HttpClient) GetFeastServingInfo(ctx context.Context, in *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error) {
+	body, err := protojson.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal GetFeastServingInfoRequest: %v", err)
+	}
+
+	respBody, err := hc.post(ctx, "/api/v1/serving/info", body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &serving.GetFeastServingInfoResponse{}
+	if err := protojson.Unmarshal(respBody, resp); err != nil {
+		return nil, fmt.Errorf("could not unmarshal GetFeastServingInfoResponse: %v", err)
+	}
+
+	return resp, nil
+}
+
+// Close is a no-op for HttpClient, which holds no persistent connection, and
+// exists to satisfy the Client interface.
+func (hc *HttpClient) Close() error {
+	return nil
+}
+
+func (hc *HttpClient) post(ctx context.Context, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hc.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if hc.authHeader != "" {
+		req.Header.Set("Authorization", hc.authHeader)
+	}
+
+	resp, err := hc.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not reach Feast Serving at %s: %v", hc.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response from %s: %v", hc.baseURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, status.Error(httpStatusToCode(resp.StatusCode), string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// httpStatusToCode maps an HTTP status code returned by Feast Serving's
+// HTTP/JSON gateway to the equivalent gRPC code, following the same mapping
+// grpc-gateway uses, so that HttpClient errors are inspectable via
+// status.FromError/codes.Code just like GrpcClient's.
+func httpStatusToCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusInternalServerError:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
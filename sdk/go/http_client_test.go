@@ -0,0 +1,132 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feast
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestHttpClientGetOnlineFeatures(t *testing.T) {
+	defer gock.Off()
+
+	req := &OnlineFeaturesRequest{
+		Features: []string{"driver:rating", "driver:null_value"},
+		Entities: []Row{
+			{"driver_id": Int64Val(1)},
+		},
+		Project: "driver_project",
+	}
+
+	gock.New("http://localhost:8080").
+		Post("/api/v1/serving/online-features").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"fieldValues": []map[string]interface{}{
+				{
+					"fields": map[string]interface{}{
+						"driver:rating":     map[string]interface{}{"int64Val": "1"},
+						"driver:null_value": map[string]interface{}{},
+					},
+					"statuses": map[string]interface{}{
+						"driver:rating":     "PRESENT",
+						"driver:null_value": "NULL_VALUE",
+					},
+				},
+			},
+		})
+
+	client := NewHTTPClient("http://localhost:8080")
+	got, err := client.GetOnlineFeatures(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetOnlineFeatures returned error: %v", err)
+	}
+
+	if len(got.RawResponse.FieldValues) != 1 {
+		t.Fatalf("expected 1 field values entry, got %d", len(got.RawResponse.FieldValues))
+	}
+
+	if !gock.IsDone() {
+		t.Fatalf("expected all mocked requests to be made")
+	}
+}
+
+func TestHttpClientAddsAuthHeader(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://localhost:8080").
+		Post("/api/v1/serving/online-features").
+		MatchHeader("Authorization", "^Bearer test-token$").
+		Reply(200).
+		JSON(map[string]interface{}{"fieldValues": []map[string]interface{}{}})
+
+	client := NewHTTPClient("http://localhost:8080", WithBearerToken("test-token"))
+	req := &OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []Row{{"driver_id": Int64Val(1)}},
+		Project:  "driver_project",
+	}
+
+	if _, err := client.GetOnlineFeatures(context.Background(), req); err != nil {
+		t.Fatalf("GetOnlineFeatures returned error: %v", err)
+	}
+
+	if !gock.IsDone() {
+		t.Fatalf("expected the Authorization header to be matched")
+	}
+}
+
+func TestHttpClientMapsNon200ResponsesToGrpcStatus(t *testing.T) {
+	tt := []struct {
+		name       string
+		httpStatus int
+		wantCode   codes.Code
+	}{
+		{name: "not found", httpStatus: 404, wantCode: codes.NotFound},
+		{name: "unauthenticated", httpStatus: 401, wantCode: codes.Unauthenticated},
+		{name: "unavailable", httpStatus: 503, wantCode: codes.Unavailable},
+		{name: "unrecognized status", httpStatus: 418, wantCode: codes.Unknown},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			defer gock.Off()
+
+			gock.New("http://localhost:8080").
+				Post("/api/v1/serving/online-features").
+				Reply(tc.httpStatus).
+				BodyString("feast serving error")
+
+			client := NewHTTPClient("http://localhost:8080")
+			req := &OnlineFeaturesRequest{
+				Features: []string{"driver:rating"},
+				Entities: []Row{{"driver_id": Int64Val(1)}},
+				Project:  "driver_project",
+			}
+
+			_, err := client.GetOnlineFeatures(context.Background(), req)
+			if err == nil {
+				t.Fatalf("expected an error for HTTP status %d", tc.httpStatus)
+			}
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("got code %v, want %v", got, tc.wantCode)
+			}
+		})
+	}
+}
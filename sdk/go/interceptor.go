@@ -0,0 +1,38 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feast
+
+import "context"
+
+// Handler is the terminal (or next) step in an interceptor chain. It is
+// ultimately backed by the real GetOnlineFeatures call against Feast Serving.
+type Handler func(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error)
+
+// Interceptor wraps a GetOnlineFeatures call with cross-cutting behaviour
+// (retries, timeouts, metrics, logging, caching, ...). Interceptors call
+// next to continue the chain, or return early to short-circuit it.
+type Interceptor func(ctx context.Context, req *OnlineFeaturesRequest, next Handler) (*OnlineFeaturesResponse, error)
+
+// chainInterceptors composes interceptors, in order, around final so that
+// interceptors[0] runs first and wraps everything after it.
+func chainInterceptors(interceptors []Interceptor, final Handler) Handler {
+	if len(interceptors) == 0 {
+		return final
+	}
+
+	return func(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+		return interceptors[0](ctx, req, chainInterceptors(interceptors[1:], final))
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+type cacheEntry struct {
+	resp      *feast.OnlineFeaturesResponse
+	expiresAt time.Time
+}
+
+// cacheStore owns the cache's map and a janitor goroutine that periodically
+// sweeps expired entries, so that entries for keys which are never looked up
+// again don't accumulate for the lifetime of the process.
+type cacheStore struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func (s *cacheStore) sweep() {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, entry := range s.entries {
+		if now.After(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+func (s *cacheStore) runJanitor(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// CacheOption configures the Cache interceptor.
+type CacheOption func(*cacheConfig)
+
+type cacheConfig struct {
+	sweepInterval time.Duration
+}
+
+// WithSweepInterval overrides how often the cache's background janitor scans
+// for and evicts expired entries. It defaults to the cache's ttl.
+func WithSweepInterval(d time.Duration) CacheOption {
+	return func(c *cacheConfig) { c.sweepInterval = d }
+}
+
+// Cache returns an interceptor that caches GetOnlineFeatures responses, keyed
+// on the request's project, entity rows and feature references, for ttl, and
+// a stop function that shuts down its background janitor.
+//
+// The janitor goroutine periodically evicts expired entries (by default
+// every ttl) so that keys which are never looked up again don't accumulate
+// indefinitely. Callers must call stop once the interceptor is no longer in
+// use, e.g. alongside the client's Close:
+//
+//	cache, stopCache := interceptor.Cache(time.Minute)
+//	defer stopCache()
+//	client, _ := feast.NewGrpcClient(host, port, feast.WithInterceptors(cache))
+func Cache(ttl time.Duration, opts ...CacheOption) (feast.Interceptor, func()) {
+	cfg := &cacheConfig{sweepInterval: ttl}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	store := &cacheStore{entries: map[string]cacheEntry{}}
+
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	go store.runJanitor(cfg.sweepInterval, stop)
+
+	interceptor := func(ctx context.Context, req *feast.OnlineFeaturesRequest, next feast.Handler) (*feast.OnlineFeaturesResponse, error) {
+		key := cacheKey(req)
+
+		store.mu.Lock()
+		entry, ok := store.entries[key]
+		store.mu.Unlock()
+		if ok && time.Now().Before(entry.expiresAt) {
+			return entry.resp, nil
+		}
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		store.mu.Lock()
+		store.entries[key] = cacheEntry{resp: resp, expiresAt: time.Now().Add(ttl)}
+		store.mu.Unlock()
+
+		return resp, nil
+	}
+
+	return interceptor, func() { stopOnce.Do(func() { close(stop) }) }
+}
+
+// cacheKey derives a stable cache key from the parts of a request that
+// determine its response: the project, the requested feature references, and
+// the entity rows being looked up.
+func cacheKey(req *feast.OnlineFeaturesRequest) string {
+	features := append([]string(nil), req.Features...)
+	sort.Strings(features)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s|%s|", req.Project, strings.Join(features, ","))
+
+	for _, row := range req.Entities {
+		keys := make([]string, 0, len(row))
+		for k := range row {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			fmt.Fprintf(&sb, "%s=%s;", k, row[k].String())
+		}
+		sb.WriteString("|")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+func testRequest() *feast.OnlineFeaturesRequest {
+	return &feast.OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+		Project:  "driver_project",
+	}
+}
+
+func TestCacheHitsWithinTTL(t *testing.T) {
+	calls := 0
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		calls++
+		return &feast.OnlineFeaturesResponse{}, nil
+	})
+
+	interceptor, stop := Cache(time.Minute)
+	defer stop()
+	req := testRequest()
+
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("got %d calls to the underlying handler, want 1 (second call should be served from cache)", calls)
+	}
+}
+
+func TestCacheExpiresAfterTTL(t *testing.T) {
+	calls := 0
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		calls++
+		return &feast.OnlineFeaturesResponse{}, nil
+	})
+
+	interceptor, stop := Cache(5 * time.Millisecond)
+	defer stop()
+	req := testRequest()
+
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("got %d calls to the underlying handler, want 2 (entry should have expired)", calls)
+	}
+}
+
+func TestCacheDoesNotCacheErrors(t *testing.T) {
+	calls := 0
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		calls++
+		return nil, context.DeadlineExceeded
+	})
+
+	interceptor, stop := Cache(time.Minute)
+	defer stop()
+	req := testRequest()
+
+	interceptor(context.Background(), req, final)
+	interceptor(context.Background(), req, final)
+
+	if calls != 2 {
+		t.Errorf("got %d calls to the underlying handler, want 2 (errors should never be cached)", calls)
+	}
+}
+
+func TestCacheStopHaltsTheJanitor(t *testing.T) {
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		return &feast.OnlineFeaturesResponse{}, nil
+	})
+
+	interceptor, stop := Cache(5*time.Millisecond, WithSweepInterval(5*time.Millisecond))
+	req := testRequest()
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Stopping immediately should not race with, or block on, the janitor.
+	stop()
+	stop() // calling stop twice must not panic
+
+	// Give a would-be leaked janitor goroutine a chance to run; it must not,
+	// since we've already stopped it.
+	time.Sleep(20 * time.Millisecond)
+}
+
+func TestCacheJanitorEvictsExpiredEntries(t *testing.T) {
+	store := &cacheStore{entries: map[string]cacheEntry{}}
+	store.entries["expired"] = cacheEntry{resp: &feast.OnlineFeaturesResponse{}, expiresAt: time.Now().Add(-time.Minute)}
+	store.entries["fresh"] = cacheEntry{resp: &feast.OnlineFeaturesResponse{}, expiresAt: time.Now().Add(time.Hour)}
+
+	store.sweep()
+
+	if _, ok := store.entries["expired"]; ok {
+		t.Errorf("expected the janitor sweep to evict the expired entry")
+	}
+	if _, ok := store.entries["fresh"]; !ok {
+		t.Errorf("expected the janitor sweep to keep the unexpired entry")
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"log"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+const redacted = "[REDACTED]"
+
+// Logger is the subset of *log.Logger used by Logging, so callers can supply
+// their own structured logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// LoggingOption configures the Logging interceptor.
+type LoggingOption func(*loggingConfig)
+
+type loggingConfig struct {
+	logger       Logger
+	redactFields map[string]bool
+}
+
+// WithLogger overrides the logger used to emit request/response log lines,
+// defaulting to the standard library's log.Default().
+func WithLogger(l Logger) LoggingOption {
+	return func(c *loggingConfig) { c.logger = l }
+}
+
+// WithRedactedFields marks feature/entity field names whose values should be
+// replaced with a redaction placeholder before being logged, e.g. PII fields.
+func WithRedactedFields(fields ...string) LoggingOption {
+	return func(c *loggingConfig) {
+		for _, f := range fields {
+			c.redactFields[f] = true
+		}
+	}
+}
+
+// Logging returns an interceptor that logs each GetOnlineFeatures request and
+// its outcome, redacting any field names configured via WithRedactedFields.
+func Logging(opts ...LoggingOption) feast.Interceptor {
+	cfg := &loggingConfig{
+		logger:       log.Default(),
+		redactFields: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(ctx context.Context, req *feast.OnlineFeaturesRequest, next feast.Handler) (*feast.OnlineFeaturesResponse, error) {
+		cfg.logger.Printf("feast: GetOnlineFeatures request project=%s features=%v entities=%v",
+			req.Project, req.Features, redactEntities(req.Entities, cfg.redactFields))
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			cfg.logger.Printf("feast: GetOnlineFeatures failed project=%s err=%v", req.Project, err)
+			return resp, err
+		}
+
+		cfg.logger.Printf("feast: GetOnlineFeatures succeeded project=%s", req.Project)
+		return resp, nil
+	}
+}
+
+func redactEntities(entities []feast.Row, redactFields map[string]bool) []feast.Row {
+	if len(redactFields) == 0 {
+		return entities
+	}
+
+	redactedEntities := make([]feast.Row, len(entities))
+	for i, row := range entities {
+		redactedRow := feast.Row{}
+		for k, v := range row {
+			if redactFields[k] {
+				redactedRow[k] = feast.StrVal(redacted)
+				continue
+			}
+			redactedRow[k] = v
+		}
+		redactedEntities[i] = redactedRow
+	}
+	return redactedEntities
+}
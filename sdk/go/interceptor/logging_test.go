@@ -0,0 +1,103 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+type fakeLogger struct {
+	lines []string
+}
+
+func (l *fakeLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func (l *fakeLogger) all() string {
+	return strings.Join(l.lines, "\n")
+}
+
+func TestLoggingRedactsConfiguredFields(t *testing.T) {
+	logger := &fakeLogger{}
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		return &feast.OnlineFeaturesResponse{}, nil
+	})
+
+	req := &feast.OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []feast.Row{{"driver_ssn": feast.StrVal("123-45-6789")}},
+		Project:  "driver_project",
+	}
+
+	interceptor := Logging(WithLogger(logger), WithRedactedFields("driver_ssn"))
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(logger.all(), "123-45-6789") {
+		t.Errorf("expected redacted field value not to appear in logs, got:\n%s", logger.all())
+	}
+	if !strings.Contains(logger.all(), redacted) {
+		t.Errorf("expected redaction placeholder %q to appear in logs, got:\n%s", redacted, logger.all())
+	}
+}
+
+func TestLoggingLogsUnredactedFieldsByDefault(t *testing.T) {
+	logger := &fakeLogger{}
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		return &feast.OnlineFeaturesResponse{}, nil
+	})
+
+	req := &feast.OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+		Project:  "driver_project",
+	}
+
+	interceptor := Logging(WithLogger(logger))
+	if _, err := interceptor(context.Background(), req, final); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(logger.all(), "driver_project") {
+		t.Errorf("expected request project to appear in logs, got:\n%s", logger.all())
+	}
+	if strings.Contains(logger.all(), redacted) {
+		t.Errorf("expected no redaction placeholder without WithRedactedFields, got:\n%s", logger.all())
+	}
+}
+
+func TestLoggingLogsFailures(t *testing.T) {
+	logger := &fakeLogger{}
+	wantErr := "serving unavailable"
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		return nil, fmt.Errorf(wantErr)
+	})
+
+	interceptor := Logging(WithLogger(logger))
+	if _, err := interceptor(context.Background(), &feast.OnlineFeaturesRequest{}, final); err == nil {
+		t.Fatalf("expected an error to be returned")
+	}
+
+	if !strings.Contains(logger.all(), wantErr) {
+		t.Errorf("expected the failure to be logged, got:\n%s", logger.all())
+	}
+}
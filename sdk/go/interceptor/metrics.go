@@ -0,0 +1,77 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "feast",
+		Subsystem: "serving_client",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of GetOnlineFeatures calls made by the Feast Go client.",
+	}, []string{"project", "success"})
+
+	requestCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "feast",
+		Subsystem: "serving_client",
+		Name:      "requests_total",
+		Help:      "Number of GetOnlineFeatures calls made by the Feast Go client.",
+	}, []string{"project", "success"})
+)
+
+func init() {
+	prometheus.MustRegister(requestDuration, requestCount)
+}
+
+// Metrics returns an interceptor that records Prometheus metrics and an
+// OpenTelemetry span for every GetOnlineFeatures call.
+func Metrics() feast.Interceptor {
+	tracer := otel.Tracer("github.com/feast-dev/feast/sdk/go/interceptor")
+
+	return func(ctx context.Context, req *feast.OnlineFeaturesRequest, next feast.Handler) (*feast.OnlineFeaturesResponse, error) {
+		ctx, span := tracer.Start(ctx, "feast.GetOnlineFeatures", trace.WithAttributes(
+			attribute.String("feast.project", req.Project),
+			attribute.Int("feast.num_entities", len(req.Entities)),
+			attribute.Int("feast.num_features", len(req.Features)),
+		))
+		defer span.End()
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		elapsed := time.Since(start).Seconds()
+
+		success := strconv.FormatBool(err == nil)
+		requestDuration.WithLabelValues(req.Project, success).Observe(elapsed)
+		requestCount.WithLabelValues(req.Project, success).Inc()
+
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return resp, err
+	}
+}
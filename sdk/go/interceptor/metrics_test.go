@@ -0,0 +1,57 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+func TestMetricsRecordsSuccessAndFailureCounts(t *testing.T) {
+	before := testutil.ToFloat64(requestCount.WithLabelValues("metrics_test_project", "true"))
+
+	ok := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		return &feast.OnlineFeaturesResponse{}, nil
+	})
+	interceptor := Metrics()
+	req := &feast.OnlineFeaturesRequest{Project: "metrics_test_project"}
+
+	if _, err := interceptor(context.Background(), req, ok); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	after := testutil.ToFloat64(requestCount.WithLabelValues("metrics_test_project", "true"))
+	if after != before+1 {
+		t.Errorf("got success count %v, want %v", after, before+1)
+	}
+
+	failBefore := testutil.ToFloat64(requestCount.WithLabelValues("metrics_test_project", "false"))
+	failing := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		return nil, errors.New("boom")
+	})
+	if _, err := interceptor(context.Background(), req, failing); err == nil {
+		t.Fatalf("expected an error to be returned")
+	}
+
+	failAfter := testutil.ToFloat64(requestCount.WithLabelValues("metrics_test_project", "false"))
+	if failAfter != failBefore+1 {
+		t.Errorf("got failure count %v, want %v", failAfter, failBefore+1)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interceptor provides built-in feast.Interceptor implementations
+// for cross-cutting concerns (retries, timeouts, metrics, logging, caching)
+// that can be attached to a feast.GrpcClient via feast.WithInterceptors.
+package interceptor
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+// IsRetryable classifies whether an error returned by Feast Serving is safe
+// to retry, i.e. the request is known to be idempotent and was not applied.
+type IsRetryable func(err error) bool
+
+// RetryOption configures the Retry interceptor.
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	isRetryable IsRetryable
+}
+
+// WithMaxAttempts sets the maximum number of attempts (including the first),
+// defaulting to 3.
+func WithMaxAttempts(n int) RetryOption {
+	return func(c *retryConfig) { c.maxAttempts = n }
+}
+
+// WithBackoff sets the base and max delay used for exponential backoff between
+// retries, defaulting to 100ms and 2s.
+func WithBackoff(base, max time.Duration) RetryOption {
+	return func(c *retryConfig) {
+		c.baseDelay = base
+		c.maxDelay = max
+	}
+}
+
+// WithRetryableFunc overrides which errors are considered retryable. By
+// default, every error is retried.
+func WithRetryableFunc(fn IsRetryable) RetryOption {
+	return func(c *retryConfig) { c.isRetryable = fn }
+}
+
+// Retry returns an interceptor that retries GetOnlineFeatures calls with
+// exponential backoff and jitter, up to the configured number of attempts.
+func Retry(opts ...RetryOption) feast.Interceptor {
+	cfg := &retryConfig{
+		maxAttempts: 3,
+		baseDelay:   100 * time.Millisecond,
+		maxDelay:    2 * time.Second,
+		isRetryable: func(err error) bool { return err != nil },
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(ctx context.Context, req *feast.OnlineFeaturesRequest, next feast.Handler) (*feast.OnlineFeaturesResponse, error) {
+		var resp *feast.OnlineFeaturesResponse
+		var err error
+
+		for attempt := 0; attempt < cfg.maxAttempts; attempt++ {
+			resp, err = next(ctx, req)
+			if err == nil || !cfg.isRetryable(err) {
+				return resp, err
+			}
+
+			if attempt == cfg.maxAttempts-1 {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoffDelay(cfg.baseDelay, cfg.maxDelay, attempt)):
+			}
+		}
+
+		return resp, err
+	}
+}
+
+func backoffDelay(base, max time.Duration, attempt int) time.Duration {
+	delay := base * time.Duration(1<<uint(attempt))
+	if delay > max {
+		delay = max
+	}
+	// Full jitter, to avoid retry storms across concurrent callers.
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+func TestRetryRetriesUpToMaxAttempts(t *testing.T) {
+	wantErr := errors.New("unavailable")
+	attempts := 0
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		attempts++
+		return nil, wantErr
+	})
+
+	interceptor := Retry(WithMaxAttempts(3), WithBackoff(time.Millisecond, 5*time.Millisecond))
+	_, err := interceptor(context.Background(), &feast.OnlineFeaturesRequest{}, final)
+
+	if err != wantErr {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+	if attempts != 3 {
+		t.Errorf("got %d attempts, want 3", attempts)
+	}
+}
+
+func TestRetryStopsOnSuccess(t *testing.T) {
+	attempts := 0
+	want := &feast.OnlineFeaturesResponse{}
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		attempts++
+		if attempts < 2 {
+			return nil, errors.New("transient")
+		}
+		return want, nil
+	})
+
+	interceptor := Retry(WithMaxAttempts(5), WithBackoff(time.Millisecond, 5*time.Millisecond))
+	got, err := interceptor(context.Background(), &feast.OnlineFeaturesRequest{}, final)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Errorf("got response %v, want %v", got, want)
+	}
+	if attempts != 2 {
+		t.Errorf("got %d attempts, want 2", attempts)
+	}
+}
+
+func TestRetryHonoursIsRetryable(t *testing.T) {
+	wantErr := errors.New("not found")
+	attempts := 0
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		attempts++
+		return nil, wantErr
+	})
+
+	interceptor := Retry(
+		WithMaxAttempts(3),
+		WithRetryableFunc(func(err error) bool { return false }),
+	)
+	_, err := interceptor(context.Background(), &feast.OnlineFeaturesRequest{}, final)
+
+	if err != wantErr {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+	if attempts != 1 {
+		t.Errorf("got %d attempts, want 1 since the error is classified as non-retryable", attempts)
+	}
+}
+
+func TestRetryStopsOnContextCancellation(t *testing.T) {
+	attempts := 0
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		attempts++
+		return nil, errors.New("transient")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	interceptor := Retry(WithMaxAttempts(5), WithBackoff(10*time.Millisecond, 50*time.Millisecond))
+	_, err := interceptor(ctx, &feast.OnlineFeaturesRequest{}, final)
+
+	if err != context.Canceled {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+	if attempts != 1 {
+		t.Errorf("got %d attempts, want 1 before the cancelled context aborts the retry wait", attempts)
+	}
+}
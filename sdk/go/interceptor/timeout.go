@@ -0,0 +1,33 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+// Timeout returns an interceptor that bounds each GetOnlineFeatures call to
+// the given per-request timeout. A tighter deadline already on ctx still
+// applies.
+func Timeout(d time.Duration) feast.Interceptor {
+	return func(ctx context.Context, req *feast.OnlineFeaturesRequest, next feast.Handler) (*feast.OnlineFeaturesResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		return next(ctx, req)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+)
+
+func TestTimeoutBoundsTheContext(t *testing.T) {
+	var sawDeadline bool
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		_, sawDeadline = ctx.Deadline()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	interceptor := Timeout(10 * time.Millisecond)
+	_, err := interceptor(context.Background(), &feast.OnlineFeaturesRequest{}, final)
+
+	if !sawDeadline {
+		t.Errorf("expected the handler to observe a context deadline")
+	}
+	if err != context.DeadlineExceeded {
+		t.Errorf("got error %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestTimeoutDoesNotOverrideATighterParentDeadline(t *testing.T) {
+	parentCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	final := feast.Handler(func(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	interceptor := Timeout(time.Hour)
+	_, err := interceptor(parentCtx, &feast.OnlineFeaturesRequest{}, final)
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("got error %v, want context.DeadlineExceeded from the parent context", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feast
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// recordingInterceptor appends name to calls on entry and exit, letting tests
+// assert ordering without touching the gRPC layer at all.
+func recordingInterceptor(name string, calls *[]string) Interceptor {
+	return func(ctx context.Context, req *OnlineFeaturesRequest, next Handler) (*OnlineFeaturesResponse, error) {
+		*calls = append(*calls, name+":before")
+		resp, err := next(ctx, req)
+		*calls = append(*calls, name+":after")
+		return resp, err
+	}
+}
+
+func TestChainInterceptorsOrdering(t *testing.T) {
+	var calls []string
+	final := func(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+		calls = append(calls, "final")
+		return &OnlineFeaturesResponse{}, nil
+	}
+
+	chain := chainInterceptors([]Interceptor{
+		recordingInterceptor("a", &calls),
+		recordingInterceptor("b", &calls),
+	}, final)
+
+	if _, err := chain(context.Background(), &OnlineFeaturesRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"a:before", "b:before", "final", "b:after", "a:after"}
+	if len(calls) != len(want) {
+		t.Fatalf("got calls %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("got calls %v, want %v", calls, want)
+		}
+	}
+}
+
+func TestChainInterceptorsShortCircuit(t *testing.T) {
+	wantErr := errors.New("blocked")
+	blocking := func(ctx context.Context, req *OnlineFeaturesRequest, next Handler) (*OnlineFeaturesResponse, error) {
+		return nil, wantErr
+	}
+	calledFinal := false
+	final := func(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+		calledFinal = true
+		return &OnlineFeaturesResponse{}, nil
+	}
+
+	chain := chainInterceptors([]Interceptor{blocking}, final)
+	_, err := chain(context.Background(), &OnlineFeaturesRequest{})
+
+	if err != wantErr {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+	if calledFinal {
+		t.Errorf("expected final handler not to be called when an interceptor short-circuits")
+	}
+}
+
+func TestChainInterceptorsEmpty(t *testing.T) {
+	final := func(ctx context.Context, req *OnlineFeaturesRequest) (*OnlineFeaturesResponse, error) {
+		return &OnlineFeaturesResponse{}, nil
+	}
+
+	chain := chainInterceptors(nil, final)
+	if _, err := chain(context.Background(), &OnlineFeaturesRequest{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feast
+
+import (
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+)
+
+// Row is a map of feature/entity name to its associated value, representing
+// a single entity key in a GetOnlineFeatures request.
+type Row map[string]*types.Value
+
+// OnlineFeaturesRequest is a request to retrieve online features for a batch
+// of entity rows.
+type OnlineFeaturesRequest struct {
+	// Features is a list of feature references, e.g. "driver:rating".
+	Features []string
+	// Entities is a list of entity rows to look up features for.
+	Entities []Row
+	// Project is the Feast project to query features from.
+	Project string
+}
+
+// OnlineFeaturesResponse wraps the raw GetOnlineFeaturesResponse returned by
+// Feast Serving.
+type OnlineFeaturesResponse struct {
+	RawResponse *serving.GetOnlineFeaturesResponse
+}
+
+// buildRequest converts an OnlineFeaturesRequest into the wire representation
+// expected by Feast Serving.
+func (r OnlineFeaturesRequest) buildRequest() (*serving.GetOnlineFeaturesRequestV2, error) {
+	entityRows := make([]*serving.GetOnlineFeaturesRequestV2_EntityRow, len(r.Entities))
+	for i, row := range r.Entities {
+		entityRows[i] = &serving.GetOnlineFeaturesRequestV2_EntityRow{
+			Fields: row,
+		}
+	}
+
+	featureRefs := make([]*serving.FeatureReferenceV2, len(r.Features))
+	for i, ref := range r.Features {
+		featureRefs[i] = &serving.FeatureReferenceV2{
+			FeatureTable: "",
+			Name:         ref,
+		}
+	}
+
+	return &serving.GetOnlineFeaturesRequestV2{
+		Features:   featureRefs,
+		EntityRows: entityRows,
+		Project:    r.Project,
+	}, nil
+}
+
+// Int64Val wraps an int64 in the protobuf Value used by Feast's type system.
+func Int64Val(val int64) *types.Value {
+	return &types.Value{Val: &types.Value_Int64Val{Int64Val: val}}
+}
+
+// StrVal wraps a string in the protobuf Value used by Feast's type system.
+func StrVal(val string) *types.Value {
+	return &types.Value{Val: &types.Value_StringVal{StringVal: val}}
+}